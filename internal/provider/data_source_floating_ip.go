@@ -9,8 +9,11 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/oxidecomputer/oxide.go/oxide"
@@ -61,16 +64,20 @@ func (d *floatingIpDataSource) Schema(ctx context.Context, req datasource.Schema
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"project_name": schema.StringAttribute{
-				Required:    true,
-				Description: "Name of the project that contains the floating IP.",
+				Optional:    true,
+				Description: "Name of the project that contains the floating IP. Required if `project_id` is not set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("project_id"), path.MatchRoot("project_name")),
+				},
 			},
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Name of the floating IP.",
 			},
 			"project_id": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "ID of the project that contains the floating IP.",
+				Description: "ID of the project that contains the floating IP. Required if `project_name` is not set.",
 			},
 			"description": schema.StringAttribute{
 				Computed:    true,
@@ -122,9 +129,14 @@ func (d *floatingIpDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	ctx, cancel := context.WithTimeout(ctx, readTimeout)
 	defer cancel()
 
+	project := state.ProjectID.ValueString()
+	if project == "" {
+		project = state.ProjectName.ValueString()
+	}
+
 	params := oxide.FloatingIpViewParams{
 		FloatingIp: oxide.NameOrId(state.Name.ValueString()),
-		Project:    oxide.NameOrId(state.ProjectName.ValueString()),
+		Project:    oxide.NameOrId(project),
 	}
 	floatingIp, err := d.client.FloatingIpView(ctx, params)
 	if err != nil {
@@ -0,0 +1,269 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oxidecomputer/oxide.go/oxide"
+)
+
+var (
+	_ datasource.DataSource              = (*floatingIpsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*floatingIpsDataSource)(nil)
+)
+
+// NewFloatingIpsDataSource initialises a floating IPs datasource
+func NewFloatingIpsDataSource() datasource.DataSource {
+	return &floatingIpsDataSource{}
+}
+
+type floatingIpsDataSource struct {
+	client *oxide.Client
+}
+
+type floatingIpsDataSourceModel struct {
+	ID          types.String              `tfsdk:"id"`
+	ProjectID   types.String              `tfsdk:"project_id"`
+	ProjectName types.String              `tfsdk:"project_name"`
+	Attached    types.Bool                `tfsdk:"attached"`
+	IPPoolID    types.String              `tfsdk:"ip_pool_id"`
+	InstanceID  types.String              `tfsdk:"instance_id"`
+	FloatingIPs []floatingIpListItemModel `tfsdk:"floating_ips"`
+	Timeouts    timeouts.Value            `tfsdk:"timeouts"`
+}
+
+// floatingIpListItemModel mirrors the fields of floatingIpDataSourceModel,
+// without its project_name and timeouts inputs, for use as an item in the
+// floating_ips list attribute.
+type floatingIpListItemModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	IP           types.String `tfsdk:"ip"`
+	IPPoolID     types.String `tfsdk:"ip_pool_id"`
+	InstanceId   types.String `tfsdk:"instance_id"`
+	TimeCreated  types.String `tfsdk:"time_created"`
+	TimeModified types.String `tfsdk:"time_modified"`
+}
+
+// floatingIpsDataSourceID computes a stable identifier for the datasource
+// from the project and the filters applied to its results, so that two
+// data blocks on the same project with different filters don't collide.
+func floatingIpsDataSourceID(project, ipPoolFilter, instanceFilter string, attached types.Bool) string {
+	h := sha256.New()
+	for _, field := range []string{project, ipPoolFilter, instanceFilter, attached.String()} {
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// floatingIpMatchesFilters reports whether floatingIp satisfies the
+// optional attached/ip_pool_id/instance_id filters. An unset (null) filter
+// always matches.
+func floatingIpMatchesFilters(floatingIp oxide.FloatingIp, attached types.Bool, ipPoolFilter, instanceFilter string) bool {
+	if !attached.IsNull() {
+		isAttached := floatingIp.InstanceId != ""
+		if attached.ValueBool() != isAttached {
+			return false
+		}
+	}
+	if ipPoolFilter != "" && floatingIp.IpPoolId != ipPoolFilter {
+		return false
+	}
+	if instanceFilter != "" && floatingIp.InstanceId != instanceFilter {
+		return false
+	}
+	return true
+}
+
+func (d *floatingIpsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "oxide_floating_ips"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *floatingIpsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*oxide.Client)
+}
+
+func (d *floatingIpsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the project to list floating IPs from. Required if `project_id` is not set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("project_id"), path.MatchRoot("project_name")),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the project to list floating IPs from. Required if `project_name` is not set.",
+			},
+			"attached": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, only return floating IPs that are currently attached to an instance; if false, only return floating IPs that are not attached.",
+			},
+			"ip_pool_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return floating IPs that belong to this IP pool.",
+			},
+			"instance_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return floating IPs attached to this instance.",
+			},
+			"timeouts": timeouts.Attributes(ctx),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique identifier for this datasource: hash of the project and the `attached`, `ip_pool_id`, and `instance_id` filters used.",
+			},
+			"floating_ips": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Collection of floating IPs in the project that match the given filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Unique, immutable, system-controlled identifier of the floating IP.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the floating IP.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "Description for the floating IP.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the project that contains the floating IP.",
+						},
+						"ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "IP address of the floating IP.",
+						},
+						"ip_pool_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the IP Pool containing the floating IP.",
+						},
+						"instance_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the instance using the floating IP.",
+						},
+						"time_created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp of when this floating IP was created.",
+						},
+						"time_modified": schema.StringAttribute{
+							Computed:    true,
+							Description: "Timestamp of when this floating IP was last modified.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *floatingIpsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state floatingIpsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	project := state.ProjectID.ValueString()
+	if project == "" {
+		project = state.ProjectName.ValueString()
+	}
+
+	var floatingIps []oxide.FloatingIp
+	pageToken := ""
+	for {
+		params := oxide.FloatingIpListParams{
+			Project:   oxide.NameOrId(project),
+			PageToken: pageToken,
+		}
+
+		page, err := d.client.FloatingIpList(ctx, params)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to list floating IPs:",
+				"API error: "+err.Error(),
+			)
+			return
+		}
+
+		floatingIps = append(floatingIps, page.Items...)
+
+		if page.NextPage == "" {
+			break
+		}
+		pageToken = page.NextPage
+	}
+
+	ipPoolFilter := state.IPPoolID.ValueString()
+	instanceFilter := state.InstanceID.ValueString()
+
+	// Initialize as an empty (non-nil) slice so that a zero-match result
+	// serializes to an empty list rather than a null one; Terraform's
+	// for_each rejects a null list.
+	state.FloatingIPs = []floatingIpListItemModel{}
+
+	for _, floatingIp := range floatingIps {
+		if !floatingIpMatchesFilters(floatingIp, state.Attached, ipPoolFilter, instanceFilter) {
+			continue
+		}
+
+		state.FloatingIPs = append(state.FloatingIPs, floatingIpListItemModel{
+			ID:           types.StringValue(floatingIp.Id),
+			Name:         types.StringValue(string(floatingIp.Name)),
+			Description:  types.StringValue(floatingIp.Description),
+			ProjectID:    types.StringValue(floatingIp.ProjectId),
+			IP:           types.StringValue(floatingIp.Ip),
+			IPPoolID:     types.StringValue(floatingIp.IpPoolId),
+			InstanceId:   types.StringValue(floatingIp.InstanceId),
+			TimeCreated:  types.StringValue(floatingIp.TimeCreated.String()),
+			TimeModified: types.StringValue(floatingIp.TimeModified.String()),
+		})
+	}
+
+	state.ID = types.StringValue(floatingIpsDataSourceID(project, ipPoolFilter, instanceFilter, state.Attached))
+
+	tflog.Trace(ctx, fmt.Sprintf("read floating IPs for project: %v", project), map[string]any{"success": true})
+
+	// Save state into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
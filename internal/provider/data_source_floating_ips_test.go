@@ -0,0 +1,86 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/oxidecomputer/oxide.go/oxide"
+)
+
+func TestFloatingIpMatchesFilters(t *testing.T) {
+	attachedIp := oxide.FloatingIp{IpPoolId: "pool-1", InstanceId: "instance-1"}
+	detachedIp := oxide.FloatingIp{IpPoolId: "pool-2", InstanceId: ""}
+
+	cases := []struct {
+		name           string
+		floatingIp     oxide.FloatingIp
+		attached       types.Bool
+		ipPoolFilter   string
+		instanceFilter string
+		want           bool
+	}{
+		{"no filters matches attached", attachedIp, types.BoolNull(), "", "", true},
+		{"no filters matches detached", detachedIp, types.BoolNull(), "", "", true},
+		{"attached=true excludes detached", detachedIp, types.BoolValue(true), "", "", false},
+		{"attached=true includes attached", attachedIp, types.BoolValue(true), "", "", true},
+		{"attached=false excludes attached", attachedIp, types.BoolValue(false), "", "", false},
+		{"attached=false includes detached", detachedIp, types.BoolValue(false), "", "", true},
+		{"ip_pool_id mismatch excludes", attachedIp, types.BoolNull(), "pool-2", "", false},
+		{"ip_pool_id match includes", attachedIp, types.BoolNull(), "pool-1", "", true},
+		{"instance_id mismatch excludes", attachedIp, types.BoolNull(), "", "instance-2", false},
+		{"instance_id match includes", attachedIp, types.BoolNull(), "", "instance-1", true},
+		{"combined filters all match", attachedIp, types.BoolValue(true), "pool-1", "instance-1", true},
+		{"combined filters one mismatch excludes", attachedIp, types.BoolValue(true), "pool-1", "instance-2", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := floatingIpMatchesFilters(c.floatingIp, c.attached, c.ipPoolFilter, c.instanceFilter)
+			if got != c.want {
+				t.Errorf("floatingIpMatchesFilters() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestFloatingIpMatchesFiltersNoneMatch(t *testing.T) {
+	ips := []oxide.FloatingIp{
+		{IpPoolId: "pool-1", InstanceId: ""},
+		{IpPoolId: "pool-2", InstanceId: ""},
+	}
+
+	var matched []floatingIpListItemModel
+	for _, ip := range ips {
+		if floatingIpMatchesFilters(ip, types.BoolValue(true), "", "") {
+			matched = append(matched, floatingIpListItemModel{IPPoolID: types.StringValue(ip.IpPoolId)})
+		}
+	}
+
+	if len(matched) != 0 {
+		t.Fatalf("expected no floating IPs to match attached=true, got %d", len(matched))
+	}
+}
+
+func TestFloatingIpsDataSourceID(t *testing.T) {
+	base := floatingIpsDataSourceID("project-1", "", "", types.BoolNull())
+
+	if got := floatingIpsDataSourceID("project-1", "", "", types.BoolNull()); got != base {
+		t.Errorf("expected identical inputs to produce the same id")
+	}
+	if got := floatingIpsDataSourceID("project-2", "", "", types.BoolNull()); got == base {
+		t.Errorf("expected different project to change the id")
+	}
+	if got := floatingIpsDataSourceID("project-1", "pool-1", "", types.BoolNull()); got == base {
+		t.Errorf("expected ip_pool_id filter to change the id")
+	}
+	if got := floatingIpsDataSourceID("project-1", "", "instance-1", types.BoolNull()); got == base {
+		t.Errorf("expected instance_id filter to change the id")
+	}
+	if got := floatingIpsDataSourceID("project-1", "", "", types.BoolValue(true)); got == base {
+		t.Errorf("expected attached filter to change the id")
+	}
+}
@@ -9,11 +9,13 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/oxidecomputer/oxide.go/oxide"
@@ -40,7 +42,9 @@ type floatingIpResourceModel struct {
 	Name         types.String   `tfsdk:"name"`
 	Description  types.String   `tfsdk:"description"`
 	ProjectID    types.String   `tfsdk:"project_id"`
+	ProjectName  types.String   `tfsdk:"project_name"`
 	IPPoolID     types.String   `tfsdk:"ip_pool_id"`
+	IPPoolName   types.String   `tfsdk:"ip_pool_name"`
 	IP           types.String   `tfsdk:"ip"`
 	TimeCreated  types.String   `tfsdk:"time_created"`
 	TimeModified types.String   `tfsdk:"time_modified"`
@@ -72,20 +76,26 @@ func (r *floatingIpResource) Schema(ctx context.Context, _ resource.SchemaReques
 			"name": schema.StringAttribute{
 				Required:    true,
 				Description: "Name of the floating IP.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"description": schema.StringAttribute{
 				Required:    true,
 				Description: "Description for the floating IP.",
+			},
+			"project_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project that will contain the floating IP. Required if `project_name` is not set.",
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("project_id"), path.MatchRoot("project_name")),
+				},
 			},
-			"project_id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of the project that will contain the floating IP.",
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the project that will contain the floating IP. Required if `project_id` is not set.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -97,14 +107,28 @@ func (r *floatingIpResource) Schema(ctx context.Context, _ resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					ipAddress(),
+				},
 			},
 			"ip_pool_id": schema.StringAttribute{
 				Optional:    true,
+				Computed:    true,
 				Description: "ID of the IP pool that will contain the floating IP.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ip_pool_name")),
+				},
+			},
+			"ip_pool_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the IP pool that will contain the floating IP.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
@@ -145,13 +169,23 @@ func (r *floatingIpResource) Create(ctx context.Context, req resource.CreateRequ
 	ctx, cancel := context.WithTimeout(ctx, createTimeout)
 	defer cancel()
 
+	project := plan.ProjectID.ValueString()
+	if project == "" {
+		project = plan.ProjectName.ValueString()
+	}
+
+	pool := plan.IPPoolID.ValueString()
+	if pool == "" {
+		pool = plan.IPPoolName.ValueString()
+	}
+
 	params := oxide.FloatingIpCreateParams{
-		Project: oxide.NameOrId(plan.ProjectID.ValueString()),
+		Project: oxide.NameOrId(project),
 		Body: &oxide.FloatingIpCreate{
 			Description: plan.Description.ValueString(),
 			Ip:          plan.IP.ValueString(),
 			Name:        oxide.Name(plan.Name.ValueString()),
-			Pool:        oxide.NameOrId(plan.IPPoolID.ValueString()),
+			Pool:        oxide.NameOrId(pool),
 		},
 	}
 
@@ -168,6 +202,8 @@ func (r *floatingIpResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(floatingIp.Id)
+	plan.ProjectID = types.StringValue(floatingIp.ProjectId)
+	plan.IPPoolID = types.StringValue(floatingIp.IpPoolId)
 	plan.TimeCreated = types.StringValue(floatingIp.TimeCreated.String())
 	plan.TimeModified = types.StringValue(floatingIp.TimeModified.String())
 
@@ -233,9 +269,56 @@ func (r *floatingIpResource) Read(ctx context.Context, req resource.ReadRequest,
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *floatingIpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Error updating floatingIp",
-		"the oxide API currently does not support updating floatingIps")
+	var plan floatingIpResourceModel
+	var state floatingIpResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The computed id is unknown in an update plan; take it from prior state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	params := oxide.FloatingIpUpdateParams{
+		FloatingIp: oxide.NameOrId(state.ID.ValueString()),
+		Body: &oxide.FloatingIpUpdate{
+			Name:        oxide.Name(plan.Name.ValueString()),
+			Description: plan.Description.ValueString(),
+		},
+	}
+
+	floatingIp, err := r.client.FloatingIpUpdate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating floatingIp",
+			"API error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated floatingIp with ID: %v", floatingIp.Id), map[string]any{"success": true})
+
+	plan.ID = types.StringValue(floatingIp.Id)
+	plan.TimeCreated = types.StringValue(floatingIp.TimeCreated.String())
+	plan.TimeModified = types.StringValue(floatingIp.TimeModified.String())
+
+	// Save plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
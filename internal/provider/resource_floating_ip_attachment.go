@@ -0,0 +1,234 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oxidecomputer/oxide.go/oxide"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = (*floatingIpAttachmentResource)(nil)
+	_ resource.ResourceWithConfigure = (*floatingIpAttachmentResource)(nil)
+)
+
+// NewFloatingIpAttachmentResource is a helper function to simplify the provider implementation.
+func NewFloatingIpAttachmentResource() resource.Resource {
+	return &floatingIpAttachmentResource{}
+}
+
+// floatingIpAttachmentResource is the resource implementation.
+type floatingIpAttachmentResource struct {
+	client *oxide.Client
+}
+
+type floatingIpAttachmentResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	FloatingIPID types.String   `tfsdk:"floating_ip_id"`
+	InstanceID   types.String   `tfsdk:"instance_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *floatingIpAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "oxide_floating_ip_attachment"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *floatingIpAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*oxide.Client)
+}
+
+func (r *floatingIpAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("floating_ip_id"), req, resp)
+}
+
+// Schema defines the schema for the resource.
+func (r *floatingIpAttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"floating_ip_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the floating IP to attach.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the instance to attach the floating IP to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Unique, immutable, system-controlled identifier of the floating IP.",
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *floatingIpAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan floatingIpAttachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	params := oxide.FloatingIpAttachParams{
+		FloatingIp: oxide.NameOrId(plan.FloatingIPID.ValueString()),
+		Body: &oxide.FloatingIpAttach{
+			Kind:   oxide.FloatingIpParentKindInstance,
+			Parent: oxide.NameOrId(plan.InstanceID.ValueString()),
+		},
+	}
+
+	floatingIp, err := r.client.FloatingIpAttach(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error attaching floatingIp",
+			"API error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("attached floatingIp with ID: %v", floatingIp.Id), map[string]any{"success": true})
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(floatingIp.Id)
+
+	// Save plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *floatingIpAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state floatingIpAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	params := oxide.FloatingIpViewParams{
+		FloatingIp: oxide.NameOrId(state.FloatingIPID.ValueString()),
+	}
+	floatingIp, err := r.client.FloatingIpView(ctx, params)
+	if err != nil {
+		if is404(err) {
+			// Remove resource from state during a refresh
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Unable to read floatingIp:",
+			"API error: "+err.Error(),
+		)
+		return
+	}
+
+	if floatingIp.InstanceId == "" {
+		// The floating IP is no longer attached to any instance; the
+		// attachment no longer exists.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("read floatingIp with ID: %v", floatingIp.Id), map[string]any{"success": true})
+
+	state.ID = types.StringValue(floatingIp.Id)
+	state.FloatingIPID = types.StringValue(floatingIp.Id)
+	state.InstanceID = types.StringValue(floatingIp.InstanceId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *floatingIpAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Error updating floatingIpAttachment",
+		"floatingIpAttachment does not support updates; both attributes require replacement")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *floatingIpAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state floatingIpAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultTimeout())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	params := oxide.FloatingIpDetachParams{
+		FloatingIp: oxide.NameOrId(state.FloatingIPID.ValueString()),
+	}
+	if _, err := r.client.FloatingIpDetach(ctx, params); err != nil {
+		if !is404(err) {
+			resp.Diagnostics.AddError(
+				"Unable to detach floatingIp:",
+				"API error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("detached floatingIp with ID: %v", state.FloatingIPID.ValueString()), map[string]any{"success": true})
+}
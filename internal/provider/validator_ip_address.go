@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// ipAddressValidator validates that an attribute's value, if configured, is a
+// syntactically valid IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+// ipAddress returns a validator which ensures the configured attribute value
+// parses as a valid IPv4 or IPv6 address.
+func ipAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v ipAddressValidator) Description(_ context.Context) string {
+	return "value must be a valid IPv4 or IPv6 address"
+}
+
+// MarkdownDescription returns a markdown formatted description of the
+// validator's behavior.
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v ipAddressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(req.ConfigValue.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("%q is not a valid IPv4 or IPv6 address", req.ConfigValue.ValueString()),
+		)
+	}
+}